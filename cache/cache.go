@@ -0,0 +1,141 @@
+// Package cache provides a bounded, TTL-aware store for rendered page
+// artifacts, keyed by a page identifier such as a route path plus a hash of
+// its args. It lets a router restore a previously visited page instantly
+// instead of re-running its (potentially expensive) Mount logic.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// entry is a single cached artifact and the time it was stored, used to
+// enforce SetTimeout.
+type entry struct {
+	data     []byte
+	storedAt time.Time
+}
+
+// Cache stores byte-serialized page artifacts keyed by a page identifier. It
+// is safe for concurrent use. The zero value is not usable; create one with
+// New.
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[string]*entry
+	order   []string // insertion order, oldest first, for eviction
+
+	maxPages int
+	maxSize  int
+	timeout  time.Duration
+}
+
+// New creates an empty Cache with no limits. Use SetMaxPages, SetMaxSize and
+// SetTimeout to bound it.
+func New() *Cache {
+	return &Cache{
+		entries: make(map[string]*entry),
+	}
+}
+
+// SetMaxPages sets the maximum number of entries the cache will hold. Once
+// exceeded, the oldest entry is evicted. A value of 0 means unlimited.
+func (c *Cache) SetMaxPages(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxPages = n
+	c.evict()
+}
+
+// SetMaxSize sets the maximum total size, in bytes, the cache will hold.
+// Once exceeded, the oldest entries are evicted until it fits. A value of 0
+// means unlimited.
+func (c *Cache) SetMaxSize(bytesLimit int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxSize = bytesLimit
+	c.evict()
+}
+
+// SetTimeout sets how long an entry may live in the cache before it is
+// treated as expired and evicted on the next Get. A value of 0 means entries
+// never expire.
+func (c *Cache) SetTimeout(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.timeout = d
+}
+
+// Put stores data under key, evicting the oldest entries if this exceeds the
+// limits set by SetMaxPages or SetMaxSize.
+func (c *Cache) Put(key string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists {
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = &entry{
+		data:     data,
+		storedAt: time.Now(),
+	}
+
+	c.evict()
+}
+
+// Get returns the cached data for key. It returns false if there is no entry
+// for key, or the entry has expired per SetTimeout, in which case the entry
+// is evicted.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	expired := ok && c.timeout > 0 && time.Since(e.storedAt) > c.timeout
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	if expired {
+		c.mu.Lock()
+		c.removeLocked(key)
+		c.mu.Unlock()
+		return nil, false
+	}
+
+	return e.data, true
+}
+
+// evict removes the oldest entries until the cache satisfies maxPages and
+// maxSize. Callers must hold c.mu.
+func (c *Cache) evict() {
+	for c.maxPages > 0 && len(c.order) > c.maxPages {
+		c.removeLocked(c.order[0])
+	}
+	for c.maxSize > 0 && c.totalSizeLocked() > c.maxSize && len(c.order) > 0 {
+		c.removeLocked(c.order[0])
+	}
+}
+
+// totalSizeLocked returns the combined size, in bytes, of every cached
+// entry. Callers must hold c.mu.
+func (c *Cache) totalSizeLocked() int {
+	total := 0
+	for _, e := range c.entries {
+		total += len(e.data)
+	}
+	return total
+}
+
+// removeLocked deletes key from both the entry map and the eviction order.
+// Callers must hold c.mu.
+func (c *Cache) removeLocked(key string) {
+	delete(c.entries, key)
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
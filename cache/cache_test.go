@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCacheGetMiss(t *testing.T) {
+	c := New()
+
+	if _, ok := c.Get("missing"); ok {
+		t.Fatalf("Get(%q) reported a hit on an empty cache", "missing")
+	}
+}
+
+func TestCachePutGet(t *testing.T) {
+	c := New()
+	c.Put("a", []byte("hello"))
+
+	data, ok := c.Get("a")
+	if !ok {
+		t.Fatalf("Get(%q) = false, want true", "a")
+	}
+	if string(data) != "hello" {
+		t.Fatalf("Get(%q) = %q, want %q", "a", data, "hello")
+	}
+}
+
+func TestCacheSetMaxPagesEvictsOldest(t *testing.T) {
+	c := New()
+	c.SetMaxPages(2)
+
+	c.Put("a", []byte("1"))
+	c.Put("b", []byte("2"))
+	c.Put("c", []byte("3"))
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get(%q) = true, want the oldest entry to have been evicted", "a")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatalf("Get(%q) = false, want it to still be cached", "b")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatalf("Get(%q) = false, want it to still be cached", "c")
+	}
+}
+
+func TestCacheSetMaxSizeEvictsUntilItFits(t *testing.T) {
+	c := New()
+	c.SetMaxSize(5)
+
+	c.Put("a", []byte("123")) // size 3
+	c.Put("b", []byte("45"))  // size 2, total 5, fits
+	c.Put("c", []byte("6"))   // size 1, total would be 6, evicts "a"
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get(%q) = true, want it evicted once the total size exceeded the limit", "a")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatalf("Get(%q) = false, want it to still be cached", "b")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatalf("Get(%q) = false, want it to still be cached", "c")
+	}
+}
+
+func TestCacheSetTimeoutExpiresEntries(t *testing.T) {
+	c := New()
+	c.SetTimeout(10 * time.Millisecond)
+	c.Put("a", []byte("1"))
+
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("Get(%q) = false, want it to still be cached immediately after Put", "a")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get(%q) = true, want it expired after the timeout elapsed", "a")
+	}
+}
+
+// TestCacheConcurrentAccess exercises Get and SetTimeout concurrently so
+// -race can catch any read of c.timeout outside of c.mu.
+func TestCacheConcurrentAccess(t *testing.T) {
+	c := New()
+	c.Put("a", []byte("1"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.Get("a")
+		}()
+		go func() {
+			defer wg.Done()
+			c.SetTimeout(time.Millisecond)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,27 @@
+package router
+
+// Receiver is an optional interface a Page can implement to react to data
+// broadcast with Router.Broadcast, even while it isn't the current page.
+// This lets background pages respond to events such as network responses,
+// config changes, or IPC messages without polling CtxValue.
+//
+// Receive returns true if the page handled data. The return value is
+// currently informational only; Broadcast still delivers data to every page
+// in the history regardless of what earlier pages returned.
+type Receiver interface {
+	Receive(data any) bool
+}
+
+// Broadcast delivers data to every page currently in the history, not just
+// the current one, for any page that implements Receiver. Broadcast runs on
+// the same goroutine as Update and Draw, so Receiver implementations don't
+// need their own locking around state shared with those methods.
+func (r *Router) Broadcast(data any) {
+	for _, page := range r.pageHistory {
+		receiver, ok := page.(Receiver)
+		if !ok {
+			continue
+		}
+		receiver.Receive(data)
+	}
+}
@@ -0,0 +1,80 @@
+package router
+
+import "github.com/lcox74/paprika/cache"
+
+// Cacheable is an optional interface a Page can implement to opt into the
+// router's page cache. When a Page implements Cacheable, Push, Pop, Back and
+// Forward serialize it with Marshal just before it is unmounted, and restore
+// it with Unmarshal instead of running Mount when it becomes current again
+// and a cache entry is still present.
+type Cacheable interface {
+	// CacheKey returns the identifier this page's state should be stored
+	// and looked up under, e.g. a route path plus a hash of its args.
+	CacheKey() string
+
+	// Marshal serializes the page's current state for storage in the cache.
+	Marshal() ([]byte, error)
+
+	// Unmarshal restores the page's state from previously cached data.
+	Unmarshal(data []byte) error
+}
+
+// WithCache configures the cache the router consults in Push, Pop, Back and
+// Forward to restore Cacheable pages without re-running Mount. If this is
+// not set, the router does not cache pages.
+//
+// Example:
+//
+//	pageCache := cache.New()
+//	pageCache.SetMaxPages(20)
+//	pageCache.SetTimeout(5 * time.Minute)
+//
+//	r := router.NewRouter(
+//		router.WithCache(pageCache),
+//	)
+func WithCache(c *cache.Cache) RouterOption {
+	return func(r *Router) {
+		r.cache = c
+	}
+}
+
+// cacheStore serializes page and stores it in the router's cache, if one is
+// configured and page implements Cacheable. It is called just before a page
+// is unmounted.
+func (r *Router) cacheStore(page Page) {
+	if r.cache == nil || page == nil {
+		return
+	}
+
+	cacheable, ok := page.(Cacheable)
+	if !ok {
+		return
+	}
+
+	data, err := cacheable.Marshal()
+	if err != nil {
+		return
+	}
+
+	r.cache.Put(cacheable.CacheKey(), data)
+}
+
+// cacheRestore mounts page, restoring it from the router's cache instead of
+// running Mount if page implements Cacheable and a cache entry is found for
+// its key. It reports whether the page was restored from the cache, so
+// callers can skip work (such as starting a Loadable's Load) that only makes
+// sense for a freshly Mounted page.
+func (r *Router) cacheRestore(page Page) bool {
+	if r.cache != nil {
+		if cacheable, ok := page.(Cacheable); ok {
+			if data, hit := r.cache.Get(cacheable.CacheKey()); hit {
+				if err := cacheable.Unmarshal(data); err == nil {
+					return true
+				}
+			}
+		}
+	}
+
+	mountPage(r, page)
+	return false
+}
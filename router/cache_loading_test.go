@@ -0,0 +1,77 @@
+package router
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lcox74/paprika/cache"
+)
+
+// cacheableLoadTestPage implements both Cacheable and Loadable, to exercise
+// the interaction between the page cache and async loading.
+type cacheableLoadTestPage struct {
+	key       string
+	state     []byte
+	loadCalls int32
+}
+
+func (p *cacheableLoadTestPage) Mount(r *Router)   {}
+func (p *cacheableLoadTestPage) Unmount(r *Router) {}
+func (p *cacheableLoadTestPage) Update(r *Router)  {}
+func (p *cacheableLoadTestPage) Draw(r *Router)    {}
+
+func (p *cacheableLoadTestPage) CacheKey() string         { return p.key }
+func (p *cacheableLoadTestPage) Marshal() ([]byte, error) { return p.state, nil }
+func (p *cacheableLoadTestPage) Unmarshal(data []byte) error {
+	p.state = data
+	return nil
+}
+
+func (p *cacheableLoadTestPage) Load(ctx context.Context) error {
+	atomic.AddInt32(&p.loadCalls, 1)
+	return nil
+}
+
+func TestMountSkipsLoadOnCacheHit(t *testing.T) {
+	r := NewRouter(WithCache(cache.New()))
+
+	page := &cacheableLoadTestPage{key: "recipe/42"}
+	r.Push(page)
+	waitForLoad(t, r)
+
+	if calls := atomic.LoadInt32(&page.loadCalls); calls != 1 {
+		t.Fatalf("loadCalls = %d, want 1 after the initial mount", calls)
+	}
+
+	// Navigate away (caching page) and back (restoring it from the cache).
+	r.Push(&plainTestPage{})
+	r.Pop()
+	waitForLoad(t, r)
+
+	if calls := atomic.LoadInt32(&page.loadCalls); calls != 1 {
+		t.Fatalf("loadCalls = %d, want 1: Load must not re-run when the page is restored from the cache", calls)
+	}
+}
+
+func TestMountStartsLoadWhenNoCacheConfigured(t *testing.T) {
+	r := NewRouter()
+
+	page := &cacheableLoadTestPage{key: "recipe/42"}
+	r.Push(page)
+	waitForLoad(t, r)
+
+	if calls := atomic.LoadInt32(&page.loadCalls); calls != 1 {
+		t.Fatalf("loadCalls = %d, want 1 after the initial mount", calls)
+	}
+
+	// Navigate away and back; with no cache configured, cacheRestore can
+	// never report a hit, so Load must run again.
+	r.Push(&plainTestPage{})
+	r.Pop()
+	waitForLoad(t, r)
+
+	if calls := atomic.LoadInt32(&page.loadCalls); calls != 2 {
+		t.Fatalf("loadCalls = %d, want 2: without a cache configured, Load must run again on remount", calls)
+	}
+}
@@ -0,0 +1,160 @@
+package router
+
+import (
+	"context"
+	"sync"
+)
+
+// Loadable is an optional interface a Page can implement to run expensive
+// work (e.g. a network request) asynchronously. If a page implements
+// Loadable, the router calls Load in a goroutine after Mount, and keeps
+// showing the loading page (see WithLoadingPage) in place of it until Load
+// returns. This keeps Mount synchronous and cheap, so it never blocks the
+// raylib frame loop.
+//
+// Load's context is cancelled automatically if the page stops being current
+// before Load returns, e.g. on Pop or a tab switch, so abandoned work can
+// stop promptly.
+type Loadable interface {
+	Load(ctx context.Context) error
+}
+
+// WithLoadingPage sets the page shown in place of the current page while its
+// Load is in flight. If this is not set, the current page is shown
+// immediately and Update/Draw run on it even before Load returns.
+//
+// Example:
+//
+//	r := router.NewRouter(
+//		router.WithLoadingPage(&SpinnerPage{}),
+//	)
+func WithLoadingPage(page Page) RouterOption {
+	return func(r *Router) {
+		r.loadingPage = page
+	}
+}
+
+// WithErrorPage sets the page shown in place of the current page after its
+// Load returns a non-nil error. The error itself remains available through
+// LastError.
+//
+// Example:
+//
+//	r := router.NewRouter(
+//		router.WithErrorPage(&ErrPage{}),
+//	)
+func WithErrorPage(page Page) RouterOption {
+	return func(r *Router) {
+		r.errorPage = page
+	}
+}
+
+// LastError returns the error returned by the most recently finished Load,
+// or nil if the current page isn't Loadable or its last Load succeeded.
+func (r *Router) LastError() error {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+
+	return r.lastErr
+}
+
+// isLoading reports whether a Load started by startLoad is still running.
+func (r *Router) isLoading() bool {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+
+	return r.loading
+}
+
+// startLoad kicks off page's Load in the background, if it implements
+// Loadable. It is a no-op otherwise.
+func (r *Router) startLoad(page Page) {
+	loadable, ok := page.(Loadable)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.ctx)
+
+	r.loadMu.Lock()
+	r.loadCancel = cancel
+	r.loading = true
+	r.loadMu.Unlock()
+
+	go func() {
+		err := loadable.Load(ctx)
+
+		r.loadMu.Lock()
+		defer r.loadMu.Unlock()
+
+		// A load whose context was already cancelled belongs to a page
+		// that's no longer current; its result is stale, so drop it.
+		if ctx.Err() != nil {
+			return
+		}
+		r.loading = false
+		r.lastErr = err
+	}()
+}
+
+// cancelLoad cancels any in-flight Load, so navigating away from a Loadable
+// page (Pop, Back, Forward, a tab switch) aborts its pending work. It also
+// resets loading and lastErr unconditionally, so a failed Load on the page
+// being navigated away from doesn't linger and get mistaken for the next
+// page's state.
+func (r *Router) cancelLoad() {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+
+	if r.loadCancel != nil {
+		r.loadCancel()
+		r.loadCancel = nil
+	}
+	r.loading = false
+	r.lastErr = nil
+}
+
+// mount brings page into the active/current role: it restores it from the
+// cache if possible (see cacheRestore) and, if page implements Loadable and
+// wasn't just restored from the cache, kicks off its Load in the background.
+// A cache hit already has the page's state ready to go, so re-running Load
+// would redo the exact work the cache exists to avoid and would flash
+// WithLoadingPage over a page that's already current. loading and lastErr
+// are reset unconditionally before any Load is started, regardless of
+// whether page is Loadable,
+// so a page with no Load of its own never inherits a stale error or loading
+// state from whatever page was current before it.
+func (r *Router) mount(page Page) {
+	restoredFromCache := r.cacheRestore(page)
+
+	r.loadMu.Lock()
+	r.loading = false
+	r.lastErr = nil
+	r.loadMu.Unlock()
+
+	if !restoredFromCache {
+		r.startLoad(page)
+	}
+}
+
+// unmount takes page out of the active/current role: any in-flight Load for
+// it is cancelled, it's cached if possible (see cacheStore), and Unmount is
+// called.
+func (r *Router) unmount(page Page) {
+	r.cancelLoad()
+	r.cacheStore(page)
+	unmountPage(r, page)
+}
+
+// loadState groups the fields that track an in-flight (or most recently
+// finished) Load. It's embedded in Router and guarded by loadMu since Load
+// runs on its own goroutine while Update/Draw run on the render goroutine.
+type loadState struct {
+	loadMu     sync.Mutex
+	loadCancel context.CancelFunc
+	loading    bool
+	lastErr    error
+
+	loadingPage Page
+	errorPage   Page
+}
@@ -0,0 +1,94 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type loadTestPage struct {
+	load func(ctx context.Context) error
+}
+
+func (p *loadTestPage) Mount(r *Router)   {}
+func (p *loadTestPage) Unmount(r *Router) {}
+func (p *loadTestPage) Update(r *Router)  {}
+func (p *loadTestPage) Draw(r *Router)    {}
+func (p *loadTestPage) Load(ctx context.Context) error {
+	return p.load(ctx)
+}
+
+type plainTestPage struct{}
+
+func (p *plainTestPage) Mount(r *Router)   {}
+func (p *plainTestPage) Unmount(r *Router) {}
+func (p *plainTestPage) Update(r *Router)  {}
+func (p *plainTestPage) Draw(r *Router)    {}
+
+func waitForLoad(t *testing.T, r *Router) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if !r.isLoading() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("Load did not finish before the deadline")
+}
+
+func TestLastErrorClearedByNonLoadablePage(t *testing.T) {
+	r := NewRouter()
+
+	r.Push(&loadTestPage{load: func(ctx context.Context) error {
+		return errors.New("boom")
+	}})
+	waitForLoad(t, r)
+
+	if r.LastError() == nil {
+		t.Fatalf("expected LastError to report the failed Load")
+	}
+
+	// Navigating to a page that isn't Loadable must still clear the
+	// previous page's error; it shouldn't get stuck forever.
+	r.Push(&plainTestPage{})
+
+	if err := r.LastError(); err != nil {
+		t.Fatalf("LastError = %v, want nil after navigating to a non-Loadable page", err)
+	}
+	if r.isLoading() {
+		t.Fatalf("isLoading() = true, want false after navigating to a non-Loadable page")
+	}
+}
+
+func TestLastErrorClearedByNextLoadablePage(t *testing.T) {
+	r := NewRouter()
+
+	r.Push(&loadTestPage{load: func(ctx context.Context) error {
+		return errors.New("boom")
+	}})
+	waitForLoad(t, r)
+
+	if r.LastError() == nil {
+		t.Fatalf("expected LastError to report the failed Load")
+	}
+
+	done := make(chan struct{})
+	r.Push(&loadTestPage{load: func(ctx context.Context) error {
+		close(done)
+		return nil
+	}})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("second page's Load never ran")
+	}
+	waitForLoad(t, r)
+
+	if err := r.LastError(); err != nil {
+		t.Fatalf("LastError = %v, want nil after the next page's Load succeeds", err)
+	}
+}
@@ -0,0 +1,148 @@
+package router
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PageBuilder lazily constructs a Page for a registered route. args contains
+// any values passed to PushNamed merged with the typed parameters captured
+// from the URL-style path (e.g. the "id" in "/recipe/:id").
+type PageBuilder func(r *Router, args map[string]any) (Page, error)
+
+// route is a single entry in the router's named-route table. path is split
+// into segments once at registration time so matching a concrete path at
+// PushNamed time doesn't need to re-parse the pattern.
+type route struct {
+	path      string
+	segments  []string
+	hasParams bool
+	builder   PageBuilder
+}
+
+// Route registers a PageBuilder under a URL-style path. Segments prefixed
+// with ":" are treated as parameters and are captured into the args map
+// passed to the builder, e.g. registering "/recipe/:id" and pushing
+// "/recipe/42" will call the builder with args["id"] == "42".
+//
+// Example:
+//
+//	r.Route("/recipe/:id", func(r *router.Router, args map[string]any) (router.Page, error) {
+//		return &RecipePage{ID: args["id"].(string)}, nil
+//	})
+func (r *Router) Route(path string, builder PageBuilder) {
+	if r.routes == nil {
+		r.routes = make(map[string]*route)
+	}
+
+	segments := pathSegments(path)
+
+	hasParams := false
+	for _, seg := range segments {
+		if strings.HasPrefix(seg, ":") {
+			hasParams = true
+			break
+		}
+	}
+
+	r.routes[path] = &route{
+		path:      path,
+		segments:  segments,
+		hasParams: hasParams,
+		builder:   builder,
+	}
+}
+
+// PushNamed looks up a page registered with Route whose pattern matches path,
+// builds it with the given args plus any parameters captured from path, and
+// pushes it onto the history. args may be nil. An error is returned if no
+// registered route matches path or if the builder itself fails.
+//
+// Example:
+//
+//	err := r.PushNamed("/settings/wifi", nil)
+func (r *Router) PushNamed(path string, args map[string]any) error {
+	rt, params, err := r.matchRoute(path)
+	if err != nil {
+		return err
+	}
+
+	if args == nil {
+		args = make(map[string]any, len(params))
+	}
+	for k, v := range params {
+		args[k] = v
+	}
+
+	page, err := rt.builder(r, args)
+	if err != nil {
+		return fmt.Errorf("router: failed to build page for %q: %w", path, err)
+	}
+
+	r.Push(page)
+	return nil
+}
+
+// matchRoute finds the registered route whose segments match path, returning
+// the route and any named parameters captured along the way. Literal routes
+// (no ":" segments) are tried before parameterized ones, so e.g. a
+// registered "/settings/wifi" always wins over a "/settings/:id" that could
+// also match "/settings/wifi" — deterministically, rather than depending on
+// map iteration order.
+func (r *Router) matchRoute(path string) (*route, map[string]any, error) {
+	segments := pathSegments(path)
+
+	if rt, params, ok := matchRoutes(r.routes, segments, false); ok {
+		return rt, params, nil
+	}
+	if rt, params, ok := matchRoutes(r.routes, segments, true); ok {
+		return rt, params, nil
+	}
+
+	return nil, nil, fmt.Errorf("router: no route matches path %q", path)
+}
+
+// matchRoutes scans routes for the first one whose segments match. When
+// allowParams is false, routes with ":" segments are skipped.
+func matchRoutes(routes map[string]*route, segments []string, allowParams bool) (*route, map[string]any, bool) {
+	for _, rt := range routes {
+		if len(rt.segments) != len(segments) {
+			continue
+		}
+		if rt.hasParams && !allowParams {
+			continue
+		}
+
+		params := make(map[string]any)
+		matched := true
+		for i, seg := range rt.segments {
+			if strings.HasPrefix(seg, ":") {
+				params[seg[1:]] = segments[i]
+				continue
+			}
+			if seg != segments[i] {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return rt, params, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+// pathSegments splits a URL-style path into its non-empty segments, so both
+// "/recipe/:id" and "recipe/:id" normalise to the same ["recipe", ":id"].
+func pathSegments(path string) []string {
+	parts := strings.Split(path, "/")
+	segments := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			segments = append(segments, p)
+		}
+	}
+	return segments
+}
@@ -0,0 +1,63 @@
+package router
+
+import "testing"
+
+func TestPushNamedPrefersLiteralOverParamRoute(t *testing.T) {
+	// Registration order is swapped between the two subtests so a bug that
+	// depends on which route was registered first doesn't get lucky.
+	for _, order := range [][2]string{{"literal", "param"}, {"param", "literal"}} {
+		t.Run(order[0]+"-then-"+order[1], func(t *testing.T) {
+			r := NewRouter()
+
+			register := map[string]func(){
+				"literal": func() {
+					r.Route("/settings/wifi", func(r *Router, args map[string]any) (Page, error) {
+						return &countingPage{}, nil
+					})
+				},
+				"param": func() {
+					r.Route("/settings/:id", func(r *Router, args map[string]any) (Page, error) {
+						return nil, errParamRouteCalled
+					})
+				},
+			}
+			register[order[0]]()
+			register[order[1]]()
+
+			if err := r.PushNamed("/settings/wifi", nil); err != nil {
+				t.Fatalf("PushNamed returned an error, want the literal route to win: %v", err)
+			}
+		})
+	}
+}
+
+func TestPushNamedFallsBackToParamRoute(t *testing.T) {
+	r := NewRouter()
+
+	var gotID string
+	r.Route("/settings/wifi", func(r *Router, args map[string]any) (Page, error) {
+		return &countingPage{}, nil
+	})
+	r.Route("/settings/:id", func(r *Router, args map[string]any) (Page, error) {
+		gotID, _ = args["id"].(string)
+		return &countingPage{}, nil
+	})
+
+	if err := r.PushNamed("/settings/bluetooth", nil); err != nil {
+		t.Fatalf("PushNamed returned an unexpected error: %v", err)
+	}
+	if gotID != "bluetooth" {
+		t.Fatalf("args[%q] = %q, want %q", "id", gotID, "bluetooth")
+	}
+}
+
+// errParamRouteCalled is a sentinel used to fail tests loudly if the
+// parameterized route's builder runs when the literal route should have
+// matched instead.
+var errParamRouteCalled = &paramRouteCalledError{}
+
+type paramRouteCalledError struct{}
+
+func (e *paramRouteCalledError) Error() string {
+	return "param route builder was called; literal route should have matched"
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/lcox74/paprika/cache"
 )
 
 type RouterOption func(*Router)
@@ -19,6 +20,23 @@ type Router struct {
 	// oldest page will be removed.
 	history     uint
 	pageHistory []Page
+
+	// position is the index of the current page within pageHistory. It acts
+	// as a cursor rather than always pointing at the end of the slice, so
+	// Back and Forward can move through previously visited pages without
+	// discarding them. -1 means there is no current page.
+	position int
+
+	// routes holds the named-route table registered with Route, keyed by the
+	// registered path pattern.
+	routes map[string]*route
+
+	// cache, if set with WithCache, is consulted to restore Cacheable pages
+	// without re-running Mount.
+	cache *cache.Cache
+
+	// loadState tracks the in-flight Load (if any) for the current page.
+	loadState
 }
 
 const (
@@ -43,6 +61,7 @@ func NewRouter(options ...RouterOption) *Router {
 		ctx:         context.Background(),
 		defaultPage: nil,
 		history:     DefaultHistory,
+		position:    -1,
 	}
 
 	// Apply options
@@ -110,8 +129,6 @@ func WithDefaultPage(page Page) RouterOption {
 // the next iteration to see if there will be a page to display. The loop will
 // update the state of the current page and then draw the current page's state.
 func (r *Router) Run() {
-	var currentPage Page
-
 	// Keep looping until the window is closed or the context is cancelled.
 	for !rl.WindowShouldClose() {
 		select {
@@ -121,14 +138,9 @@ func (r *Router) Run() {
 			// Check the current page and if it should display the default page.
 			// If there is no default page we will continue to the next
 			// iteration to see if there will be a page to display.
-			currentPage = r.current()
+			currentPage := r.ensureCurrent()
 			if currentPage == nil {
-				if r.defaultPage != nil {
-					r.Push(r.defaultPage)
-					currentPage = r.defaultPage
-				} else {
-					continue
-				}
+				continue
 			}
 
 			// Update the state of the current page.
@@ -142,6 +154,35 @@ func (r *Router) Run() {
 	}
 }
 
+// ensureCurrent returns the page to update and draw this frame: the router's
+// current page, pushing the default page if there isn't one, or in its place
+// the error page or loading page when one is configured and the current
+// page's Load failed or is still running. It returns nil if there is no
+// current page and no default page is configured. This is shared by Router.Run and
+// TabManager.Run so both drive the same "fall back to the default page"
+// behaviour.
+func (r *Router) ensureCurrent() Page {
+	currentPage := r.current()
+	if currentPage == nil {
+		if r.defaultPage == nil {
+			return nil
+		}
+		r.Push(r.defaultPage)
+		currentPage = r.defaultPage
+	}
+
+	// Prefer the error page over the loading page, so a page that failed to
+	// load doesn't flash back to "loading" on the next frame.
+	if r.LastError() != nil && r.errorPage != nil {
+		return r.errorPage
+	}
+	if r.isLoading() && r.loadingPage != nil {
+		return r.loadingPage
+	}
+
+	return currentPage
+}
+
 // Sets a value on the router's context. This is useful for sharing data
 // between pages.
 func (r *Router) CtxSetValue(key string, value interface{}) {
@@ -154,51 +195,110 @@ func (r *Router) CtxValue(key string) interface{} {
 	return r.ctx.Value(key)
 }
 
-// Push adds a page to the history. If the history is full, then the oldest
-// page will be removed.
+// Push adds a page to the history and makes it the current page, matching
+// browser semantics: any pages ahead of the current position (reachable with
+// Forward) are discarded before the new page is appended. If the history is
+// full, then the oldest page will be removed.
 func (r *Router) Push(page Page) {
-	// If the page history is full, then remove the oldest page.
-	if len(r.pageHistory) >= int(r.history) {
-		r.pageHistory = r.pageHistory[1:]
+	// Truncate anything beyond the current position before appending.
+	if r.position+1 < len(r.pageHistory) {
+		r.pageHistory = r.pageHistory[:r.position+1]
 	}
 
-	// Unmount the last page to clean up resources.
-	if len(r.pageHistory) != 0 {
-		lastPage := r.pageHistory[len(r.pageHistory)-1]
-		unmountPage(r, lastPage)
+	// Unmount the current page before it stops being the active page.
+	if current := r.current(); current != nil {
+		r.unmount(current)
 	}
 
-	// Add new page to history and mount it.
 	r.pageHistory = append(r.pageHistory, page)
-	mountPage(r, page)
+	r.position++
+
+	// If the page history is full, then remove the oldest page and shift the
+	// position to account for it.
+	if len(r.pageHistory) > int(r.history) {
+		r.pageHistory = r.pageHistory[1:]
+		r.position--
+	}
+
+	// With WithHistory(0), the page just appended above can be evicted
+	// immediately, leaving it unreachable via current(). Only mount it if it
+	// actually made it into the history, so we never mount (and leak) a page
+	// that will never receive Update, Draw or Unmount.
+	if r.current() == page {
+		r.mount(page)
+	}
 }
 
-// Pop removes the last page from the history and returns it. If there are no
-// pages in the history, then nil is returned.
+// Pop moves the position back by one page, unmounting the current page and
+// mounting the previous one, and returns the page that was current. It does
+// not discard the page, so a subsequent Forward(1) can return to it. If
+// there are no pages in the history, then nil is returned.
 func (r *Router) Pop() Page {
-	if len(r.pageHistory) == 0 {
+	return r.Back(1)
+}
+
+// Back moves the position back by n pages within the history, unmounting the
+// page that was current and mounting the one now at the cursor. It returns
+// the page that was current before moving. Moving back past the start of the
+// history clears the current page (as if the router were empty) without
+// discarding the forward chain. If there is no current page, nil is
+// returned.
+func (r *Router) Back(n int) Page {
+	if n <= 0 || r.position < 0 {
 		return nil
 	}
 
-	// Unmount the last page and mount the previous page.
-	lastPage := r.pageHistory[len(r.pageHistory)-1]
-	unmountPage(r, lastPage)
+	previous := r.current()
+	r.unmount(previous)
+
+	r.position -= n
+	if r.position < -1 {
+		r.position = -1
+	}
 
-	// Remove last page from history and mount the previous page. If there is no
-	// previous page, then the router will be empty. If a default page is set
-	// then it will be mounted.
-	r.pageHistory = r.pageHistory[:len(r.pageHistory)-1]
-	if len(r.pageHistory) == 0 {
+	r.mount(r.current())
+
+	return previous
+}
+
+// Forward moves the position forward by n pages within the history,
+// unmounting the current page and mounting the one now at the cursor. It
+// returns the newly current page, or nil if there was nothing to move to.
+func (r *Router) Forward(n int) Page {
+	if n <= 0 || len(r.pageHistory) == 0 {
 		return nil
 	}
-	mountPage(r, r.pageHistory[len(r.pageHistory)-1])
 
-	return lastPage
+	target := r.position + n
+	if target >= len(r.pageHistory) {
+		target = len(r.pageHistory) - 1
+	}
+	if target == r.position {
+		return nil
+	}
+
+	r.unmount(r.current())
+	r.position = target
+	r.mount(r.current())
+
+	return r.current()
+}
+
+// Position returns the index of the current page within the history, or -1
+// if there is no current page.
+func (r *Router) Position() int {
+	return r.position
+}
+
+// Length returns the total number of pages kept in the history, including
+// those ahead of the current position that can be reached with Forward.
+func (r *Router) Length() int {
+	return len(r.pageHistory)
 }
 
 func (r *Router) current() Page {
-	if len(r.pageHistory) == 0 {
+	if r.position < 0 || r.position >= len(r.pageHistory) {
 		return nil
 	}
-	return r.pageHistory[len(r.pageHistory)-1]
+	return r.pageHistory[r.position]
 }
@@ -0,0 +1,134 @@
+package router
+
+import "testing"
+
+func TestPushWithZeroHistoryDoesNotMountEvictedPage(t *testing.T) {
+	r := NewRouter(WithHistory(0))
+
+	page := &countingPage{}
+	r.Push(page)
+
+	if r.current() != nil {
+		t.Fatalf("current() = %v, want nil with WithHistory(0)", r.current())
+	}
+	if page.mounts != 0 {
+		t.Fatalf("page.mounts = %d, want 0: a page evicted before it's reachable must never be mounted", page.mounts)
+	}
+}
+
+func TestPushTracksPositionAndLength(t *testing.T) {
+	r := NewRouter()
+
+	pages := []*countingPage{{}, {}, {}}
+	for _, p := range pages {
+		r.Push(p)
+	}
+
+	if got, want := r.Position(), 2; got != want {
+		t.Fatalf("Position() = %d, want %d", got, want)
+	}
+	if got, want := r.Length(), 3; got != want {
+		t.Fatalf("Length() = %d, want %d", got, want)
+	}
+	if r.current() != pages[2] {
+		t.Fatalf("current() = %v, want the last pushed page", r.current())
+	}
+}
+
+func TestBackAndForwardMovePositionWithoutDiscarding(t *testing.T) {
+	r := NewRouter()
+
+	a, b, c := &countingPage{}, &countingPage{}, &countingPage{}
+	r.Push(a)
+	r.Push(b)
+	r.Push(c)
+
+	if got := r.Back(1); got != c {
+		t.Fatalf("Back(1) returned %v, want the page that was current (c)", got)
+	}
+	if r.current() != b {
+		t.Fatalf("current() = %v, want b after Back(1)", r.current())
+	}
+	// Length is unchanged: c is still reachable via Forward.
+	if got, want := r.Length(), 3; got != want {
+		t.Fatalf("Length() = %d, want %d (Back must not discard forward history)", got, want)
+	}
+
+	if got := r.Forward(1); got != c {
+		t.Fatalf("Forward(1) = %v, want c", got)
+	}
+	if r.current() != c {
+		t.Fatalf("current() = %v, want c after Forward(1)", r.current())
+	}
+
+	// Forward past the end is a no-op.
+	if got := r.Forward(1); got != nil {
+		t.Fatalf("Forward(1) past the end = %v, want nil", got)
+	}
+	if r.current() != c {
+		t.Fatalf("current() = %v, want c unchanged after a no-op Forward", r.current())
+	}
+}
+
+func TestBackPastStartClearsCurrentPage(t *testing.T) {
+	r := NewRouter()
+
+	a := &countingPage{}
+	r.Push(a)
+
+	r.Back(5)
+
+	if r.current() != nil {
+		t.Fatalf("current() = %v, want nil after Back() past the start of history", r.current())
+	}
+	if a.unmounts != 1 {
+		t.Fatalf("a.unmounts = %d, want 1", a.unmounts)
+	}
+}
+
+func TestPushTruncatesForwardHistory(t *testing.T) {
+	r := NewRouter()
+
+	a, b, c, d := &countingPage{}, &countingPage{}, &countingPage{}, &countingPage{}
+	r.Push(a)
+	r.Push(b)
+	r.Push(c)
+
+	r.Back(1) // current is now b, c is still reachable via Forward
+
+	r.Push(d) // matches browser semantics: pushing from b drops c
+
+	if got, want := r.Length(), 3; got != want {
+		t.Fatalf("Length() = %d, want %d after Push truncated the forward entry", got, want)
+	}
+	if r.current() != d {
+		t.Fatalf("current() = %v, want d", r.current())
+	}
+	if got := r.Forward(1); got != nil {
+		t.Fatalf("Forward(1) = %v, want nil: c should have been discarded by Push", got)
+	}
+}
+
+func TestPushEvictsOldestPageOnceHistoryIsFull(t *testing.T) {
+	r := NewRouter(WithHistory(2))
+
+	a, b, c := &countingPage{}, &countingPage{}, &countingPage{}
+	r.Push(a)
+	r.Push(b)
+	r.Push(c)
+
+	if got, want := r.Length(), 2; got != want {
+		t.Fatalf("Length() = %d, want %d once history is full", got, want)
+	}
+	if got, want := r.Position(), 1; got != want {
+		t.Fatalf("Position() = %d, want %d", got, want)
+	}
+	if r.current() != c {
+		t.Fatalf("current() = %v, want c", r.current())
+	}
+	// a was evicted, so the furthest Back can reach is b.
+	r.Back(1)
+	if r.current() != b {
+		t.Fatalf("current() = %v, want b: a should have been evicted", r.current())
+	}
+}
@@ -0,0 +1,138 @@
+package router
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// TabManager owns multiple independent Router histories, one per tab, and
+// tracks which one is active. Each tab keeps its own page history and
+// state, so switching tabs doesn't disturb the others. Only the active
+// tab's current page is updated and drawn each frame; switching tabs mounts
+// the newly active page and unmounts the previously active one so
+// background tabs can release resources such as GPU textures.
+type TabManager struct {
+	tabs      []*Router
+	activeTab int
+
+	// newRouter builds the Router for a new tab, letting every tab share the
+	// same setup (history size, default page, context, routes, ...).
+	newRouter func() *Router
+}
+
+// NewTabManager creates a TabManager with a single initial tab, built with
+// newRouter. newRouter is also used by NewTab to create further tabs.
+//
+// Example:
+//
+//	tm := router.NewTabManager(func() *router.Router {
+//		return router.NewRouter(
+//			router.WithHistory(15),
+//			router.WithDefaultPage(&HomePage{}),
+//		)
+//	})
+func NewTabManager(newRouter func() *Router) *TabManager {
+	return &TabManager{
+		tabs:      []*Router{newRouter()},
+		activeTab: 0,
+		newRouter: newRouter,
+	}
+}
+
+// NewTab creates a new tab, makes it active, and returns its Router. The
+// previously active tab's current page is unmounted so it can release
+// resources while in the background.
+func (tm *TabManager) NewTab() *Router {
+	if active := tm.ActiveTab(); active != nil {
+		active.unmount(active.current())
+	}
+
+	r := tm.newRouter()
+	tm.tabs = append(tm.tabs, r)
+	tm.activeTab = len(tm.tabs) - 1
+
+	r.mount(r.current())
+
+	return r
+}
+
+// CloseTab closes the tab at index i. If i is the active tab, its current
+// page is unmounted and the tab that takes its place (or the previous tab,
+// if i was the last one) is mounted. A background tab's current page was
+// already unmounted when the tab was switched away from, so closing it
+// doesn't unmount it again. CloseTab does nothing if i is out of range.
+func (tm *TabManager) CloseTab(i int) {
+	if i < 0 || i >= len(tm.tabs) {
+		return
+	}
+
+	if i == tm.activeTab {
+		closed := tm.tabs[i]
+		closed.unmount(closed.current())
+	}
+	tm.tabs = append(tm.tabs[:i], tm.tabs[i+1:]...)
+
+	switch {
+	case len(tm.tabs) == 0:
+		tm.activeTab = 0
+	case tm.activeTab > i:
+		tm.activeTab--
+	case tm.activeTab == i:
+		if tm.activeTab >= len(tm.tabs) {
+			tm.activeTab = len(tm.tabs) - 1
+		}
+		active := tm.ActiveTab()
+		active.mount(active.current())
+	}
+}
+
+// SwitchTab makes the tab at index i active, unmounting the previously
+// active tab's current page and mounting the new tab's current page.
+// SwitchTab does nothing if i is out of range or already active.
+func (tm *TabManager) SwitchTab(i int) {
+	if i < 0 || i >= len(tm.tabs) || i == tm.activeTab {
+		return
+	}
+
+	previous := tm.ActiveTab()
+	previous.unmount(previous.current())
+	tm.activeTab = i
+	active := tm.ActiveTab()
+	active.mount(active.current())
+}
+
+// ActiveTab returns the Router for the currently active tab, or nil if
+// there are no tabs.
+func (tm *TabManager) ActiveTab() *Router {
+	if len(tm.tabs) == 0 {
+		return nil
+	}
+	return tm.tabs[tm.activeTab]
+}
+
+// Run starts the tab manager's render loop. It mirrors Router.Run, but only
+// updates and draws the active tab's current page each frame; background
+// tabs are left untouched until SwitchTab brings them to the front.
+func (tm *TabManager) Run() {
+	for !rl.WindowShouldClose() {
+		active := tm.ActiveTab()
+		if active == nil {
+			continue
+		}
+
+		select {
+		case <-active.ctx.Done():
+			return
+		default:
+			currentPage := active.ensureCurrent()
+			if currentPage == nil {
+				continue
+			}
+
+			updatePage(active, currentPage)
+
+			rl.BeginDrawing()
+			drawPage(active, currentPage)
+			rl.EndDrawing()
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package router
+
+import "testing"
+
+type countingPage struct {
+	mounts   int
+	unmounts int
+}
+
+func (p *countingPage) Mount(r *Router)   { p.mounts++ }
+func (p *countingPage) Unmount(r *Router) { p.unmounts++ }
+func (p *countingPage) Update(r *Router)  {}
+func (p *countingPage) Draw(r *Router)    {}
+
+func TestCloseTabDoesNotDoubleUnmountBackgroundTab(t *testing.T) {
+	page0 := &countingPage{}
+	page1 := &countingPage{}
+
+	tm := NewTabManager(func() *Router { return NewRouter() })
+	tm.ActiveTab().Push(page0)
+
+	tm.NewTab()
+	tm.ActiveTab().Push(page1)
+
+	// Switching back to tab 0 unmounts page1, since it's now in the
+	// background.
+	tm.SwitchTab(0)
+	if page1.unmounts != 1 {
+		t.Fatalf("page1.unmounts = %d, want 1 after switching away from its tab", page1.unmounts)
+	}
+
+	// Closing tab 1 while it's in the background must not unmount its
+	// current page a second time.
+	tm.CloseTab(1)
+	if page1.unmounts != 1 {
+		t.Fatalf("page1.unmounts = %d, want 1 after closing its (background) tab", page1.unmounts)
+	}
+}
+
+func TestCloseActiveTabUnmountsItsCurrentPage(t *testing.T) {
+	page0 := &countingPage{}
+
+	tm := NewTabManager(func() *Router { return NewRouter() })
+	tm.ActiveTab().Push(page0)
+
+	tm.CloseTab(0)
+	if page0.unmounts != 1 {
+		t.Fatalf("page0.unmounts = %d, want 1 after closing its active tab", page0.unmounts)
+	}
+}